@@ -1,9 +1,13 @@
 package eventbus
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"reflect"
+	"strings"
 	"sync"
+	"time"
 )
 
 // BusSubscriber defines subscription-related bus behavior
@@ -15,17 +19,30 @@ type BusSubscriber interface {
 	Unsubscribe(topic string, handler any) error
 	SetArgumentProcessor(topic string, argProc ArgumentProcessor)
 	SetDefaultArgumentProcessor(argProc ...ArgumentProcessor)
+	SetErrorHandler(topic string, errHandler ErrorHandler)
+	SetDefaultErrorHandler(errHandler ...ErrorHandler)
+	SubscribeFrom(topic string, fn any, offset uint64) error
+	SubscribeAsyncFrom(topic string, fn any, offset uint64, transactional bool) error
+	SetTopicRetention(topic string, capacity int, ttl time.Duration)
 }
 
 // BusPublisher defines publishing-related bus behavior
 type BusPublisher interface {
 	Publish(topic string, args ...any)
+	PublishCtx(ctx context.Context, topic string, args ...any) error
 }
 
 // BusController defines bus control behavior (checking handler's presence, synchronization)
 type BusController interface {
 	HasCallback(topic string) bool
 	WaitAsync()
+	HeadOffset(topic string) uint64
+}
+
+// BusMiddleware defines bus middleware registration behavior
+type BusMiddleware interface {
+	Use(mw PublishMiddleware)
+	UseSubscribe(mw SubscribeMiddleware)
 }
 
 // Bus englobes global (subscribe, publish, control) bus behavior
@@ -33,21 +50,55 @@ type Bus interface {
 	BusController
 	BusSubscriber
 	BusPublisher
+	BusMiddleware
 }
 
+// PublishFunc is one step of the publish middleware chain: it receives ctx
+// for cross-cutting propagation (tracing, deadlines) alongside the topic and
+// arguments being published.
+type PublishFunc = func(ctx context.Context, topic string, args []any) error
+
+// PublishMiddleware wraps a PublishFunc with cross-cutting behavior
+// (logging, tracing, metrics, auth, rate limiting) and must call next to
+// continue the chain, or return an error to short-circuit the publish.
+type PublishMiddleware = func(next PublishFunc) PublishFunc
+
+// SubscribeFunc is one step of the subscribe middleware chain.
+type SubscribeFunc = func(topic string, fn any) error
+
+// SubscribeMiddleware wraps a SubscribeFunc, mirroring PublishMiddleware for
+// the subscribe path.
+type SubscribeMiddleware = func(next SubscribeFunc) SubscribeFunc
+
 type ArgumentProcessor = func(callback *EventHandler, arg ...any) []reflect.Value
 
+// ErrorHandler is invoked instead of letting a subscriber panic, or letting
+// an argument mismatch crash the publishing goroutine. err is the recovered
+// panic value (or an argument-validation error) wrapped as an error.
+type ErrorHandler func(topic string, handler *EventHandler, args []any, err error)
+
 // EventBus - box for handlers and callbacks.
 type EventBus struct {
-	handlers       map[string][]*EventHandler
-	wg             sync.WaitGroup
-	lock           sync.Mutex // a lock for the map
-	argProcs       map[string]ArgumentProcessor
-	defaultArgProc ArgumentProcessor
+	handlers          map[string][]*EventHandler
+	patternHandlers   map[string][]*EventHandler
+	wg                sync.WaitGroup
+	lock              sync.Mutex // a lock for the maps
+	argProcs          map[string]ArgumentProcessor
+	defaultArgProc    ArgumentProcessor
+	errHandlers       map[string]ErrorHandler
+	defaultErrHandler ErrorHandler
+	procLock          sync.Mutex // a lock for argProcs, defaultArgProc, errHandlers and defaultErrHandler
+	logs              map[string]*topicLog
+	logsLock          sync.Mutex // a lock for the logs map
+	publishMW         []PublishMiddleware
+	subscribeMW       []SubscribeMiddleware
+	publishChain      PublishFunc // cached compiled chain; nil forces a rebuild
+	mwLock            sync.Mutex  // protects publishMW, subscribeMW and publishChain
 }
 
 type EventHandler struct {
 	Callback      reflect.Value
+	topic         string // exact topic or pattern the handler was subscribed with
 	once          *sync.Once
 	async         bool
 	transactional bool
@@ -57,16 +108,68 @@ type EventHandler struct {
 // New returns new EventBus with empty handlers.
 func New() Bus {
 	b := &EventBus{
+		make(map[string][]*EventHandler),
 		make(map[string][]*EventHandler),
 		sync.WaitGroup{},
 		sync.Mutex{},
 		make(map[string]ArgumentProcessor),
 		nil,
+		make(map[string]ErrorHandler),
+		nil,
+		sync.Mutex{},
+		make(map[string]*topicLog),
+		sync.Mutex{},
+		nil,
+		nil,
+		nil,
+		sync.Mutex{},
 	}
 	b.SetDefaultArgumentProcessor()
+	b.SetDefaultErrorHandler()
 	return Bus(b)
 }
 
+// logErrorHandler is the built-in default ErrorHandler: it logs the failure
+// and otherwise swallows it, preserving the bus's ability to keep dispatching
+// to other subscribers.
+func logErrorHandler(topic string, handler *EventHandler, args []any, err error) {
+	log.Printf("eventbus: handler for topic %q failed: %v", topic, err)
+}
+
+// isPatternTopic reports whether topic carries MQTT-style wildcard segments
+// ("*" matches exactly one dot-delimited segment, "#" matches the rest of
+// the topic) and therefore needs to be matched rather than looked up
+// exactly.
+func isPatternTopic(topic string) bool {
+	return strings.ContainsAny(topic, "*#")
+}
+
+// matchTopic reports whether topic satisfies pattern, both read as
+// dot-delimited segments.
+func matchTopic(pattern, topic string) bool {
+	return matchSegments(strings.Split(pattern, "."), strings.Split(topic, "."))
+}
+
+func matchSegments(pattern, topic []string) bool {
+	if len(pattern) == 0 {
+		return len(topic) == 0
+	}
+	switch pattern[0] {
+	case "#":
+		return true
+	case "*":
+		if len(topic) == 0 {
+			return false
+		}
+		return matchSegments(pattern[1:], topic[1:])
+	default:
+		if len(topic) == 0 || topic[0] != pattern[0] {
+			return false
+		}
+		return matchSegments(pattern[1:], topic[1:])
+	}
+}
+
 // doSubscribe handles the subscription logic and is utilized by the public Subscribe functions
 func (bus *EventBus) doSubscribe(topic string, fn any, handler *EventHandler) error {
 	bus.lock.Lock()
@@ -74,15 +177,29 @@ func (bus *EventBus) doSubscribe(topic string, fn any, handler *EventHandler) er
 	if !(reflect.TypeOf(fn).Kind() == reflect.Func) {
 		return fmt.Errorf("%s is not of type reflect.Func", reflect.TypeOf(fn).Kind())
 	}
-	bus.handlers[topic] = append(bus.handlers[topic], handler)
+	bus.registerHandler(topic, handler)
 	return nil
 }
 
-// Subscribe subscribes to a topic.
+// registerHandler files handler under topic in the exact-match or pattern
+// handler map. Callers must hold bus.lock.
+func (bus *EventBus) registerHandler(topic string, handler *EventHandler) {
+	handler.topic = topic
+	if isPatternTopic(topic) {
+		bus.patternHandlers[topic] = append(bus.patternHandlers[topic], handler)
+	} else {
+		bus.handlers[topic] = append(bus.handlers[topic], handler)
+	}
+}
+
+// Subscribe subscribes to a topic. topic may be an exact name
+// ("orders.created") or an MQTT-style wildcard pattern ("orders.*" matches
+// one segment, "orders.#" matches zero or more) evaluated against every
+// published topic.
 // Returns error if `fn` is not a function.
 func (bus *EventBus) Subscribe(topic string, fn any) error {
-	return bus.doSubscribe(topic, fn, &EventHandler{
-		reflect.ValueOf(fn), nil, false, false, sync.Mutex{},
+	return bus.runSubscribeChain(topic, fn, &EventHandler{
+		reflect.ValueOf(fn), "", nil, false, false, sync.Mutex{},
 	})
 }
 
@@ -91,16 +208,16 @@ func (bus *EventBus) Subscribe(topic string, fn any) error {
 // run serially (true) or concurrently (false)
 // Returns error if `fn` is not a function.
 func (bus *EventBus) SubscribeAsync(topic string, fn any, transactional bool) error {
-	return bus.doSubscribe(topic, fn, &EventHandler{
-		reflect.ValueOf(fn), nil, true, transactional, sync.Mutex{},
+	return bus.runSubscribeChain(topic, fn, &EventHandler{
+		reflect.ValueOf(fn), "", nil, true, transactional, sync.Mutex{},
 	})
 }
 
 // SubscribeOnce subscribes to a topic once. Handler will be removed after executing.
 // Returns error if `fn` is not a function.
 func (bus *EventBus) SubscribeOnce(topic string, fn any) error {
-	return bus.doSubscribe(topic, fn, &EventHandler{
-		reflect.ValueOf(fn), new(sync.Once), false, false, sync.Mutex{},
+	return bus.runSubscribeChain(topic, fn, &EventHandler{
+		reflect.ValueOf(fn), "", new(sync.Once), false, false, sync.Mutex{},
 	})
 }
 
@@ -108,42 +225,176 @@ func (bus *EventBus) SubscribeOnce(topic string, fn any) error {
 // Handler will be removed after executing.
 // Returns error if `fn` is not a function.
 func (bus *EventBus) SubscribeOnceAsync(topic string, fn any) error {
-	return bus.doSubscribe(topic, fn, &EventHandler{
-		reflect.ValueOf(fn), new(sync.Once), true, false, sync.Mutex{},
+	return bus.runSubscribeChain(topic, fn, &EventHandler{
+		reflect.ValueOf(fn), "", new(sync.Once), true, false, sync.Mutex{},
 	})
 }
 
-// HasCallback returns true if exists any callback subscribed to the topic.
+// doSubscribeFrom registers handler into the live dispatch path and replays
+// whatever retained log entries from offset onward predate that
+// registration. The snapshot of retained entries and the registration
+// itself happen atomically (see doSubscribeFromLocked), so no event
+// published concurrently with the call can be either dropped (delivered
+// neither in the replay nor live) or double-delivered (present in the
+// replay and then delivered live too).
+func (bus *EventBus) doSubscribeFrom(topic string, fn any, offset uint64, handler *EventHandler) error {
+	entries, err := bus.runSubscribeChainFrom(topic, fn, handler, offset)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !handler.async {
+			bus.doPublish(handler, topic, entry.args...)
+		} else {
+			bus.wg.Add(1)
+			if handler.transactional {
+				handler.Lock()
+			}
+			go bus.doPublishAsync(handler, topic, entry.args...)
+		}
+	}
+	return nil
+}
+
+// SubscribeFrom subscribes to a topic and synchronously replays retained log
+// entries starting at offset. fn is wired into the live dispatch path in the
+// same step that snapshots the entries to replay, before the replay runs, so
+// an event published while the replay is in progress can reach fn before the
+// replay has finished.
+// offset may be 0 (replay everything retained) or LatestOffset (skip replay
+// entirely). Retention for topic must have been configured with
+// SetTopicRetention, otherwise no entries are replayed.
+// Returns error if `fn` is not a function.
+func (bus *EventBus) SubscribeFrom(topic string, fn any, offset uint64) error {
+	return bus.doSubscribeFrom(topic, fn, offset, &EventHandler{
+		reflect.ValueOf(fn), "", nil, false, false, sync.Mutex{},
+	})
+}
+
+// SubscribeAsyncFrom is like SubscribeFrom, but replays retained entries and
+// dispatches future events through the asynchronous worker, as SubscribeAsync
+// does. transactional determines whether callbacks for topic run serially
+// (true) or concurrently (false).
+// Returns error if `fn` is not a function.
+func (bus *EventBus) SubscribeAsyncFrom(topic string, fn any, offset uint64, transactional bool) error {
+	return bus.doSubscribeFrom(topic, fn, offset, &EventHandler{
+		reflect.ValueOf(fn), "", nil, true, transactional, sync.Mutex{},
+	})
+}
+
+// SetTopicRetention enables (or reconfigures) the in-memory replay log for
+// topic. capacity bounds the number of retained entries (0 for unbounded)
+// and ttl bounds their age (0 for no expiry); whichever limit is reached
+// first evicts the oldest entries. Topics without a configured retention
+// keep no log and SubscribeFrom/SubscribeAsyncFrom replay nothing for them.
+func (bus *EventBus) SetTopicRetention(topic string, capacity int, ttl time.Duration) {
+	bus.logsLock.Lock()
+	l, ok := bus.logs[topic]
+	if !ok {
+		l = newTopicLog()
+		bus.logs[topic] = l
+	}
+	bus.logsLock.Unlock()
+	l.configure(capacity, ttl)
+}
+
+// HeadOffset returns the offset of the most recently published entry
+// retained for topic, or 0 if topic has no configured retention or nothing
+// has been published to it yet. Consumers can use it as a replay checkpoint.
+func (bus *EventBus) HeadOffset(topic string) uint64 {
+	bus.logsLock.Lock()
+	l, ok := bus.logs[topic]
+	bus.logsLock.Unlock()
+	if !ok {
+		return 0
+	}
+	return l.head()
+}
+
+// appendToLog records args under topic's replay log, if one has been
+// configured via SetTopicRetention. It is a no-op otherwise.
+func (bus *EventBus) appendToLog(topic string, args []any) {
+	bus.logsLock.Lock()
+	l, ok := bus.logs[topic]
+	bus.logsLock.Unlock()
+	if !ok {
+		return
+	}
+	l.append(args)
+}
+
+// HasCallback returns true if exists any callback subscribed to the topic,
+// either directly or through a wildcard pattern that matches it.
 func (bus *EventBus) HasCallback(topic string) bool {
 	bus.lock.Lock()
 	defer bus.lock.Unlock()
-	_, ok := bus.handlers[topic]
-	if ok {
-		return len(bus.handlers[topic]) > 0
+	if hs, ok := bus.handlers[topic]; ok && len(hs) > 0 {
+		return true
+	}
+	if hs, ok := bus.patternHandlers[topic]; ok && len(hs) > 0 {
+		return true
+	}
+	if !isPatternTopic(topic) {
+		for pattern, hs := range bus.patternHandlers {
+			if len(hs) > 0 && matchTopic(pattern, topic) {
+				return true
+			}
+		}
 	}
 	return false
 }
 
-// Unsubscribe removes callback defined for a topic.
+// Unsubscribe removes callback defined for a topic. topic must be passed
+// exactly as given to Subscribe, including wildcard patterns, since that is
+// the key the handler is filed under.
 // Returns error if there are no callbacks subscribed to the topic.
 func (bus *EventBus) Unsubscribe(topic string, handler any) error {
 	bus.lock.Lock()
 	defer bus.lock.Unlock()
-	if _, ok := bus.handlers[topic]; ok && len(bus.handlers[topic]) > 0 {
-		bus.removeHandler(topic, bus.findHandlerIdx(topic, reflect.ValueOf(handler)))
+	m := bus.handlers
+	if isPatternTopic(topic) {
+		m = bus.patternHandlers
+	}
+	if _, ok := m[topic]; ok && len(m[topic]) > 0 {
+		bus.removeHandlerFrom(m, topic, bus.findHandlerIdxIn(m, topic, reflect.ValueOf(handler)))
 		return nil
 	}
 	return fmt.Errorf("topic %s doesn't exist", topic)
 }
 
 // Publish executes callback defined for a topic. Any additional argument will be transferred to the callback.
+// Handlers subscribed to a wildcard pattern that matches topic are invoked alongside exact-match handlers.
+// It is a thin wrapper around PublishCtx using context.Background(), kept for backward compatibility;
+// a middleware that aborts the publish has its error silently dropped here.
 func (bus *EventBus) Publish(topic string, args ...any) {
+	_ = bus.PublishCtx(context.Background(), topic, args...)
+}
+
+// PublishCtx is like Publish, but runs the publish through the middleware
+// chain installed via Use, propagating ctx to each middleware, and returns
+// the first error a middleware chooses to short-circuit with.
+func (bus *EventBus) PublishCtx(ctx context.Context, topic string, args ...any) error {
+	return bus.compiledPublishChain()(ctx, topic, args)
+}
+
+// dispatch is the terminal step of the publish middleware chain: the fan-out
+// logic that used to be Publish's whole body.
+func (bus *EventBus) dispatch(ctx context.Context, topic string, args []any) error {
 	// Handlers slice may be changed by removeHandler and Unsubscribe during iteration,
-	// so make a copy and iterate the copied slice.
+	// so make a copy and iterate the copied slice. The log append happens in the same
+	// critical section as the snapshot so that SubscribeFrom's own snapshot+register
+	// (see doSubscribeFromLocked) can never race with it: bus.lock orders every
+	// published entry on one side or the other of a concurrent subscription.
 	bus.lock.Lock()
+	bus.appendToLog(topic, args)
 	handlers := bus.handlers[topic]
 	copyHandlers := make([]*EventHandler, len(handlers))
 	copy(copyHandlers, handlers)
+	for pattern, hs := range bus.patternHandlers {
+		if matchTopic(pattern, topic) {
+			copyHandlers = append(copyHandlers, hs...)
+		}
+	}
 	bus.lock.Unlock()
 	for _, handler := range copyHandlers {
 		if !handler.async {
@@ -156,23 +407,133 @@ func (bus *EventBus) Publish(topic string, args ...any) {
 			go bus.doPublishAsync(handler, topic, args...)
 		}
 	}
+	return nil
+}
+
+// compiledPublishChain returns the cached PublishFunc chain wrapping
+// dispatch with every middleware registered via Use, rebuilding it the first
+// time it's needed after a Use call.
+func (bus *EventBus) compiledPublishChain() PublishFunc {
+	bus.mwLock.Lock()
+	defer bus.mwLock.Unlock()
+	if bus.publishChain != nil {
+		return bus.publishChain
+	}
+	chain := PublishFunc(bus.dispatch)
+	for i := len(bus.publishMW) - 1; i >= 0; i-- {
+		chain = bus.publishMW[i](chain)
+	}
+	bus.publishChain = chain
+	return chain
+}
+
+// Use appends mw to the publish middleware chain. Middlewares run in
+// registration order around PublishCtx's call into the fan-out logic.
+func (bus *EventBus) Use(mw PublishMiddleware) {
+	bus.mwLock.Lock()
+	defer bus.mwLock.Unlock()
+	bus.publishMW = append(bus.publishMW, mw)
+	bus.publishChain = nil
+}
+
+// UseSubscribe appends mw to the subscribe middleware chain. Middlewares run
+// in registration order around every Subscribe/SubscribeAsync/SubscribeOnce/
+// SubscribeOnceAsync/SubscribeFrom/SubscribeAsyncFrom call.
+func (bus *EventBus) UseSubscribe(mw SubscribeMiddleware) {
+	bus.mwLock.Lock()
+	defer bus.mwLock.Unlock()
+	bus.subscribeMW = append(bus.subscribeMW, mw)
+}
+
+// runSubscribeChain wires handler into the bus through doSubscribe, routed
+// through the subscribe middleware chain.
+func (bus *EventBus) runSubscribeChain(topic string, fn any, handler *EventHandler) error {
+	bus.mwLock.Lock()
+	mws := make([]SubscribeMiddleware, len(bus.subscribeMW))
+	copy(mws, bus.subscribeMW)
+	bus.mwLock.Unlock()
+	chain := SubscribeFunc(func(topic string, fn any) error {
+		return bus.doSubscribe(topic, fn, handler)
+	})
+	for i := len(mws) - 1; i >= 0; i-- {
+		chain = mws[i](chain)
+	}
+	return chain(topic, fn)
+}
+
+// runSubscribeChainFrom is like runSubscribeChain, but its terminal step is
+// doSubscribeFromLocked instead of doSubscribe, so it returns the log
+// entries the caller still needs to replay alongside any error.
+func (bus *EventBus) runSubscribeChainFrom(topic string, fn any, handler *EventHandler, offset uint64) ([]logEntry, error) {
+	bus.mwLock.Lock()
+	mws := make([]SubscribeMiddleware, len(bus.subscribeMW))
+	copy(mws, bus.subscribeMW)
+	bus.mwLock.Unlock()
+
+	var entries []logEntry
+	chain := SubscribeFunc(func(topic string, fn any) error {
+		e, err := bus.doSubscribeFromLocked(topic, fn, handler, offset)
+		entries = e
+		return err
+	})
+	for i := len(mws) - 1; i >= 0; i-- {
+		chain = mws[i](chain)
+	}
+	err := chain(topic, fn)
+	return entries, err
+}
+
+// doSubscribeFromLocked captures the log entries retained for topic from
+// offset onward and registers handler into the live dispatch path within a
+// single bus.lock critical section. dispatch appends to the same topic's
+// log and snapshots its handlers under that same lock, so whichever side
+// wins the race for bus.lock, every published entry ends up on exactly one
+// side of the boundary: replayed here, or delivered live afterwards.
+func (bus *EventBus) doSubscribeFromLocked(topic string, fn any, handler *EventHandler, offset uint64) ([]logEntry, error) {
+	bus.lock.Lock()
+	defer bus.lock.Unlock()
+	if !(reflect.TypeOf(fn).Kind() == reflect.Func) {
+		return nil, fmt.Errorf("%s is not of type reflect.Func", reflect.TypeOf(fn).Kind())
+	}
+	var entries []logEntry
+	if offset != LatestOffset {
+		bus.logsLock.Lock()
+		l, ok := bus.logs[topic]
+		bus.logsLock.Unlock()
+		if ok {
+			entries = l.since(offset)
+		}
+	}
+	bus.registerHandler(topic, handler)
+	return entries, nil
 }
 
 func (bus *EventBus) doPublish(handler *EventHandler, topic string, args ...any) {
+	defer func() {
+		if r := recover(); r != nil {
+			bus.handlePublishError(handler, topic, args, r)
+		}
+	}()
+	bus.procLock.Lock()
 	argProc, ok := bus.argProcs[topic]
 	if !ok {
 		argProc = bus.defaultArgProc
 	}
+	bus.procLock.Unlock()
 	passedArguments := argProc(handler, args...)
 	if handler.once == nil {
 		handler.Callback.Call(passedArguments)
 	} else {
 		handler.once.Do(func() {
 			bus.lock.Lock()
-			for idx, h := range bus.handlers[topic] {
+			m := bus.handlers
+			if isPatternTopic(handler.topic) {
+				m = bus.patternHandlers
+			}
+			for idx, h := range m[handler.topic] {
 				// compare pointers since pointers are unique for all members of slice
 				if h.once == handler.once {
-					bus.removeHandler(topic, idx)
+					bus.removeHandlerFrom(m, handler.topic, idx)
 					break
 				}
 			}
@@ -182,6 +543,25 @@ func (bus *EventBus) doPublish(handler *EventHandler, topic string, args ...any)
 	}
 }
 
+// handlePublishError converts a recovered panic (or an argument-validation
+// error raised by an ArgumentProcessor) into an ErrorHandler callback,
+// preferring a per-topic handler over the bus-wide default.
+func (bus *EventBus) handlePublishError(handler *EventHandler, topic string, args []any, recovered any) {
+	err, ok := recovered.(error)
+	if !ok {
+		err = fmt.Errorf("%v", recovered)
+	}
+	bus.procLock.Lock()
+	eh, ok := bus.errHandlers[topic]
+	if !ok {
+		eh = bus.defaultErrHandler
+	}
+	bus.procLock.Unlock()
+	if eh != nil {
+		eh(topic, handler, args, err)
+	}
+}
+
 func (bus *EventBus) doPublishAsync(handler *EventHandler, topic string, args ...any) {
 	defer bus.wg.Done()
 	if handler.transactional {
@@ -190,24 +570,24 @@ func (bus *EventBus) doPublishAsync(handler *EventHandler, topic string, args ..
 	bus.doPublish(handler, topic, args...)
 }
 
-func (bus *EventBus) removeHandler(topic string, idx int) {
-	if _, ok := bus.handlers[topic]; !ok {
+func (bus *EventBus) removeHandlerFrom(m map[string][]*EventHandler, topic string, idx int) {
+	if _, ok := m[topic]; !ok {
 		return
 	}
-	l := len(bus.handlers[topic])
+	l := len(m[topic])
 
 	if !(0 <= idx && idx < l) {
 		return
 	}
 
-	copy(bus.handlers[topic][idx:], bus.handlers[topic][idx+1:])
-	bus.handlers[topic][l-1] = nil // or the zero value of T
-	bus.handlers[topic] = bus.handlers[topic][:l-1]
+	copy(m[topic][idx:], m[topic][idx+1:])
+	m[topic][l-1] = nil // or the zero value of T
+	m[topic] = m[topic][:l-1]
 }
 
-func (bus *EventBus) findHandlerIdx(topic string, callback reflect.Value) int {
-	if _, ok := bus.handlers[topic]; ok {
-		for idx, handler := range bus.handlers[topic] {
+func (bus *EventBus) findHandlerIdxIn(m map[string][]*EventHandler, topic string, callback reflect.Value) int {
+	if _, ok := m[topic]; ok {
+		for idx, handler := range m[topic] {
 			if handler.Callback.Type() == callback.Type() &&
 				handler.Callback.Pointer() == callback.Pointer() {
 				return idx
@@ -217,20 +597,55 @@ func (bus *EventBus) findHandlerIdx(topic string, callback reflect.Value) int {
 	return -1
 }
 
+// setupArguments is the built-in default ArgumentProcessor. It validates
+// that args matches the callback's arity and that each argument's kind is
+// assignable to the corresponding parameter before building the
+// reflect.Value slice, panicking with a descriptive error on mismatch so
+// doPublish's recover turns it into an ErrorHandler callback rather than a
+// runtime panic. Variadic callbacks (e.g. func(args ...any)) accept any
+// number of trailing arguments assignable to the variadic element type.
 func (bus *EventBus) setupArguments(handler *EventHandler, args ...any) []reflect.Value {
 	funcType := handler.Callback.Type()
+	if funcType.IsVariadic() {
+		fixed := funcType.NumIn() - 1
+		if len(args) < fixed {
+			panic(fmt.Errorf("eventbus: handler expects at least %d argument(s), got %d", fixed, len(args)))
+		}
+		elemType := funcType.In(fixed).Elem()
+		passedArguments := make([]reflect.Value, len(args))
+		for i, v := range args {
+			paramType := elemType
+			if i < fixed {
+				paramType = funcType.In(i)
+			}
+			passedArguments[i] = argumentValue(paramType, v)
+		}
+		return passedArguments
+	}
+	if funcType.NumIn() != len(args) {
+		panic(fmt.Errorf("eventbus: handler expects %d argument(s), got %d", funcType.NumIn(), len(args)))
+	}
 	passedArguments := make([]reflect.Value, len(args))
 	for i, v := range args {
-		if v == nil {
-			passedArguments[i] = reflect.New(funcType.In(i)).Elem()
-		} else {
-			passedArguments[i] = reflect.ValueOf(v)
-		}
+		passedArguments[i] = argumentValue(funcType.In(i), v)
 	}
 
 	return passedArguments
 }
 
+// argumentValue builds the reflect.Value to pass for a single parameter of
+// type paramType, panicking if v isn't nil or assignable to it.
+func argumentValue(paramType reflect.Type, v any) reflect.Value {
+	if v == nil {
+		return reflect.New(paramType).Elem()
+	}
+	argValue := reflect.ValueOf(v)
+	if !argValue.Type().AssignableTo(paramType) {
+		panic(fmt.Errorf("eventbus: argument of type %s is not assignable to handler parameter type %s", argValue.Type(), paramType))
+	}
+	return argValue
+}
+
 // WaitAsync waits for all async callbacks to complete
 func (bus *EventBus) WaitAsync() {
 	bus.wg.Wait()
@@ -242,6 +657,8 @@ func (bus *EventBus) WaitAsync() {
 // to the subscriber's callback. The default argument processor will be used
 // if no argument processor is set for a topic.
 func (bus *EventBus) SetArgumentProcessor(topic string, argProc ArgumentProcessor) {
+	bus.procLock.Lock()
+	defer bus.procLock.Unlock()
 	bus.argProcs[topic] = argProc
 }
 
@@ -250,9 +667,36 @@ func (bus *EventBus) SetArgumentProcessor(topic string, argProc ArgumentProcesso
 // Pass no arguments to reset the default argument processor to the built-in
 // default argument processor.
 func (bus *EventBus) SetDefaultArgumentProcessor(argProc ...ArgumentProcessor) {
+	bus.procLock.Lock()
+	defer bus.procLock.Unlock()
 	if len(argProc) > 0 {
 		bus.defaultArgProc = argProc[0]
 	} else {
 		bus.defaultArgProc = bus.setupArguments
 	}
 }
+
+// SetErrorHandler sets the ErrorHandler for a topic
+//
+// The error handler is invoked instead of panicking when a subscriber for
+// this topic panics or is called with mismatched arguments. The default
+// error handler will be used if no error handler is set for the topic.
+func (bus *EventBus) SetErrorHandler(topic string, errHandler ErrorHandler) {
+	bus.procLock.Lock()
+	defer bus.procLock.Unlock()
+	bus.errHandlers[topic] = errHandler
+}
+
+// SetDefaultErrorHandler sets the bus-wide default ErrorHandler
+//
+// Pass no arguments to reset the default error handler to the built-in
+// default, which logs the failure.
+func (bus *EventBus) SetDefaultErrorHandler(errHandler ...ErrorHandler) {
+	bus.procLock.Lock()
+	defer bus.procLock.Unlock()
+	if len(errHandler) > 0 {
+		bus.defaultErrHandler = errHandler[0]
+	} else {
+		bus.defaultErrHandler = logErrorHandler
+	}
+}
@@ -0,0 +1,271 @@
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWildcardSubscribeMatchesSegment(t *testing.T) {
+	bus := New()
+	var got string
+	bus.Subscribe("orders.*", func(topic string, amount int) {
+		got = topic
+	})
+	bus.Publish("orders.created", "orders.created", 10)
+	bus.WaitAsync()
+	if got != "orders.created" {
+		t.Fatalf("expected handler invoked with %q, got %q", "orders.created", got)
+	}
+}
+
+func TestHierarchicalWildcardMatchesZeroOrMoreSegments(t *testing.T) {
+	bus := New()
+	var calls int
+	bus.Subscribe("orders.#", func(topic string) {
+		calls++
+	})
+	bus.Publish("orders.paid.eu", "orders.paid.eu")
+	bus.Publish("billing.paid", "billing.paid")
+	bus.WaitAsync()
+	if calls != 1 {
+		t.Fatalf("expected 1 matching publish, got %d", calls)
+	}
+}
+
+func TestHasCallbackReportsPatternMatches(t *testing.T) {
+	bus := New()
+	bus.Subscribe("orders.*", func(string) {})
+	if !bus.HasCallback("orders.created") {
+		t.Fatal("expected HasCallback to report a pattern match")
+	}
+	if bus.HasCallback("billing.created") {
+		t.Fatal("expected HasCallback to reject a non-matching topic")
+	}
+}
+
+func TestUnsubscribePatternTopic(t *testing.T) {
+	bus := New()
+	fn := func(string) {}
+	bus.Subscribe("orders.*", fn)
+	if err := bus.Unsubscribe("orders.*", fn); err != nil {
+		t.Fatalf("unexpected error unsubscribing pattern topic: %v", err)
+	}
+	if bus.HasCallback("orders.created") {
+		t.Fatal("expected handler to be removed")
+	}
+}
+
+func TestErrorHandlerRecoversSubscriberPanic(t *testing.T) {
+	bus := New()
+	errs := make(chan error, 1)
+	bus.SetErrorHandler("topic", func(topic string, handler *EventHandler, args []any, err error) {
+		errs <- err
+	})
+	bus.Subscribe("topic", func(int) {
+		panic("boom")
+	})
+	bus.Publish("topic", 1)
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Fatal("expected a non-nil error")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the panic to be recovered and routed to the ErrorHandler")
+	}
+}
+
+func TestErrorHandlerCatchesArgumentMismatch(t *testing.T) {
+	bus := New()
+	errs := make(chan error, 1)
+	bus.SetErrorHandler("topic", func(topic string, handler *EventHandler, args []any, err error) {
+		errs <- err
+	})
+	bus.Subscribe("topic", func(int, int) {})
+	bus.Publish("topic", 1)
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Fatal("expected a non-nil error")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the arity mismatch to be routed to the ErrorHandler instead of panicking")
+	}
+}
+
+// TestSetErrorHandlerConcurrentWithPublish exercises SetErrorHandler and
+// Publish from separate goroutines; run with -race to confirm errHandlers
+// is no longer read and written without synchronization.
+func TestSetErrorHandlerConcurrentWithPublish(t *testing.T) {
+	bus := New()
+	bus.Subscribe("topic", func(int) { panic("boom") })
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			bus.SetErrorHandler("topic", func(string, *EventHandler, []any, error) {})
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			bus.Publish("topic", i)
+		}
+	}()
+	wg.Wait()
+}
+
+func TestSubscribeFromReplaysRetainedLog(t *testing.T) {
+	bus := New()
+	bus.SetTopicRetention("topic", 0, 0)
+	bus.Publish("topic", 1)
+	bus.Publish("topic", 2)
+	bus.Publish("topic", 3)
+
+	var got []int
+	bus.SubscribeFrom("topic", func(v int) {
+		got = append(got, v)
+	}, 0)
+
+	if len(got) != 3 || got[0] != 1 || got[1] != 2 || got[2] != 3 {
+		t.Fatalf("expected replay of [1 2 3], got %v", got)
+	}
+
+	bus.Publish("topic", 4)
+	if len(got) != 4 || got[3] != 4 {
+		t.Fatalf("expected live delivery after replay, got %v", got)
+	}
+}
+
+func TestSubscribeFromLatestOffsetSkipsReplay(t *testing.T) {
+	bus := New()
+	bus.SetTopicRetention("topic", 0, 0)
+	bus.Publish("topic", 1)
+
+	var got []int
+	bus.SubscribeFrom("topic", func(v int) {
+		got = append(got, v)
+	}, LatestOffset)
+
+	if len(got) != 0 {
+		t.Fatalf("expected no replay with LatestOffset, got %v", got)
+	}
+}
+
+// TestSubscribeFromNoGapUnderConcurrentPublish widens the window between
+// "snapshot the retained log" and "register the handler" by giving the
+// handler a small per-call delay, then hammers Publish from another
+// goroutine for the whole duration of the call. Before the fix, SubscribeFrom
+// captured its log snapshot, spent that delay replaying it, and only
+// registered the handler afterwards — every concurrent publish in between
+// was neither in the snapshot nor seen live, and was silently dropped.
+func TestSubscribeFromNoGapUnderConcurrentPublish(t *testing.T) {
+	bus := New()
+	bus.SetTopicRetention("topic", 0, 0)
+
+	const preCount = 100
+	for i := 0; i < preCount; i++ {
+		bus.Publish("topic", i)
+	}
+
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	handler := func(v int) {
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		seen[v] = true
+		mu.Unlock()
+	}
+
+	var published int32 = preCount
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				v := int(atomic.AddInt32(&published, 1)) - 1
+				bus.Publish("topic", v)
+			}
+		}
+	}()
+
+	bus.SubscribeFrom("topic", handler, 0)
+	close(stop)
+	wg.Wait()
+	bus.WaitAsync()
+
+	mu.Lock()
+	defer mu.Unlock()
+	total := int(atomic.LoadInt32(&published))
+	for i := 0; i < total; i++ {
+		if !seen[i] {
+			t.Fatalf("event %d was dropped across the replay/live boundary (published %d total)", i, total)
+		}
+	}
+}
+
+func TestHeadOffsetTracksRetainedLog(t *testing.T) {
+	bus := New()
+	bus.SetTopicRetention("topic", 0, 0)
+	if off := bus.HeadOffset("topic"); off != 0 {
+		t.Fatalf("expected head offset 0 before any publish, got %d", off)
+	}
+	bus.Publish("topic")
+	bus.Publish("topic")
+	if off := bus.HeadOffset("topic"); off != 2 {
+		t.Fatalf("expected head offset 2 after two publishes, got %d", off)
+	}
+}
+
+func TestPublishMiddlewareChainRunsInRegistrationOrder(t *testing.T) {
+	bus := New()
+	var order []string
+	bus.Use(func(next PublishFunc) PublishFunc {
+		return func(ctx context.Context, topic string, args []any) error {
+			order = append(order, "first")
+			return next(ctx, topic, args)
+		}
+	})
+	bus.Use(func(next PublishFunc) PublishFunc {
+		return func(ctx context.Context, topic string, args []any) error {
+			order = append(order, "second")
+			return next(ctx, topic, args)
+		}
+	})
+	bus.Subscribe("topic", func() {
+		order = append(order, "handler")
+	})
+	bus.Publish("topic")
+	if len(order) != 3 || order[0] != "first" || order[1] != "second" || order[2] != "handler" {
+		t.Fatalf("expected [first second handler], got %v", order)
+	}
+}
+
+func TestPublishMiddlewareShortCircuitsPublishCtx(t *testing.T) {
+	bus := New()
+	sentinel := errors.New("blocked")
+	bus.Use(func(next PublishFunc) PublishFunc {
+		return func(ctx context.Context, topic string, args []any) error {
+			return sentinel
+		}
+	})
+	var called bool
+	bus.Subscribe("topic", func() { called = true })
+	if err := bus.PublishCtx(context.Background(), "topic"); !errors.Is(err, sentinel) {
+		t.Fatalf("expected the middleware's error, got %v", err)
+	}
+	if called {
+		t.Fatal("expected the handler not to run once the middleware short-circuited")
+	}
+}
@@ -0,0 +1,95 @@
+package eventbus
+
+import (
+	"sync"
+	"time"
+)
+
+// LatestOffset is a sentinel offset for SubscribeFrom/SubscribeAsyncFrom
+// meaning "skip replay and only receive events published from now on".
+const LatestOffset uint64 = ^uint64(0)
+
+// logEntry is a single retained publish: the arguments passed to Publish,
+// its monotonic offset, and when it was recorded (used for TTL eviction).
+type logEntry struct {
+	offset uint64
+	args   []any
+	at     time.Time
+}
+
+// topicLog is a bounded, in-memory ring buffer of published events for a
+// single topic, used to replay history to late subscribers.
+type topicLog struct {
+	mu         sync.Mutex
+	entries    []logEntry
+	capacity   int           // 0 means unbounded by count
+	ttl        time.Duration // 0 means entries never expire by age
+	nextOffset uint64        // offset to assign to the next appended entry
+}
+
+// newTopicLog returns an empty topicLog. Offsets start at 1 so that 0 can be
+// used by callers to mean "replay from the beginning of what's retained".
+func newTopicLog() *topicLog {
+	return &topicLog{nextOffset: 1}
+}
+
+// configure updates the retention bounds and immediately evicts any entries
+// that no longer fit them.
+func (l *topicLog) configure(capacity int, ttl time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.capacity = capacity
+	l.ttl = ttl
+	l.evict()
+}
+
+// append records args as the next entry and returns its assigned offset.
+func (l *topicLog) append(args []any) uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	offset := l.nextOffset
+	l.nextOffset++
+	l.entries = append(l.entries, logEntry{offset: offset, args: args, at: time.Now()})
+	l.evict()
+	return offset
+}
+
+// evict drops entries past the configured ttl or capacity. Callers must hold l.mu.
+func (l *topicLog) evict() {
+	if l.ttl > 0 {
+		cutoff := time.Now().Add(-l.ttl)
+		i := 0
+		for i < len(l.entries) && l.entries[i].at.Before(cutoff) {
+			i++
+		}
+		if i > 0 {
+			l.entries = append([]logEntry(nil), l.entries[i:]...)
+		}
+	}
+	if l.capacity > 0 && len(l.entries) > l.capacity {
+		l.entries = append([]logEntry(nil), l.entries[len(l.entries)-l.capacity:]...)
+	}
+}
+
+// since returns the retained entries with an offset >= offset, in
+// publication order. Passing 0 returns every retained entry.
+func (l *topicLog) since(offset uint64) []logEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.evict()
+	out := make([]logEntry, 0, len(l.entries))
+	for _, e := range l.entries {
+		if e.offset >= offset {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// head returns the offset of the most recently appended entry, or 0 if
+// nothing has been published yet.
+func (l *topicLog) head() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.nextOffset - 1
+}
@@ -0,0 +1,45 @@
+package networkbus
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// Codec encodes and decodes the values exchanged between NetworkBus peers
+// (SubscribeArg and ClientArg), so Transports whose peers aren't necessarily
+// other go-eventbus processes can still interoperate.
+type Codec interface {
+	Marshal(v any) ([]byte, error)
+	Unmarshal(data []byte, v any) error
+}
+
+// JSONCodec is the default Codec. It's the most portable across languages,
+// at the cost of ClientArg.Args losing Go-specific type information (e.g.
+// numbers decode as float64).
+type JSONCodec struct{}
+
+func (JSONCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONCodec) Unmarshal(data []byte, v any) error {
+	return json.Unmarshal(data, v)
+}
+
+// GobCodec preserves Go types exactly, but only interoperates with other Go
+// processes, and any concrete type passed as a ClientArg.Args element must
+// be registered with gob.Register by both ends beforehand.
+type GobCodec struct{}
+
+func (GobCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (GobCodec) Unmarshal(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
@@ -0,0 +1,78 @@
+package networkbus
+
+import (
+	"fmt"
+	"reflect"
+
+	eventbus "github.com/sotvokun/go-eventbus"
+)
+
+// coercingArgumentProcessor is installed for every topic NetworkBus.Subscribe
+// wires up locally. A forwarded event's arguments have round-tripped through
+// a Codec (JSONCodec by default), which loses Go-specific type information —
+// every number decodes as float64, regardless of what the original publisher
+// sent. The bus's default ArgumentProcessor requires an exact assignable
+// type and panics otherwise, so without this, forwarding an int (or any
+// other numeric type) across a NetworkBus never reaches the subscriber.
+// This mirrors EventBus.setupArguments' arity checking, but falls back to a
+// numeric conversion when the decoded value isn't already assignable to the
+// handler's parameter type.
+func coercingArgumentProcessor(handler *eventbus.EventHandler, args ...any) []reflect.Value {
+	funcType := handler.Callback.Type()
+	if funcType.IsVariadic() {
+		fixed := funcType.NumIn() - 1
+		if len(args) < fixed {
+			panic(fmt.Errorf("networkbus: handler expects at least %d argument(s), got %d", fixed, len(args)))
+		}
+		elemType := funcType.In(fixed).Elem()
+		passedArguments := make([]reflect.Value, len(args))
+		for i, v := range args {
+			paramType := elemType
+			if i < fixed {
+				paramType = funcType.In(i)
+			}
+			passedArguments[i] = coerceArgument(paramType, v)
+		}
+		return passedArguments
+	}
+	if funcType.NumIn() != len(args) {
+		panic(fmt.Errorf("networkbus: handler expects %d argument(s), got %d", funcType.NumIn(), len(args)))
+	}
+	passedArguments := make([]reflect.Value, len(args))
+	for i, v := range args {
+		passedArguments[i] = coerceArgument(funcType.In(i), v)
+	}
+	return passedArguments
+}
+
+// coerceArgument builds the reflect.Value to pass for a single parameter of
+// type paramType. It prefers a direct assignment, as the bus's own default
+// ArgumentProcessor does, and only falls back to a numeric conversion (e.g.
+// the float64 a JSON-decoded int becomes) when both sides are numeric
+// kinds. It panics if v is neither assignable nor convertible, same as the
+// bus's default processor, so doPublish's recover still routes the failure
+// to an ErrorHandler instead of crashing.
+func coerceArgument(paramType reflect.Type, v any) reflect.Value {
+	if v == nil {
+		return reflect.New(paramType).Elem()
+	}
+	argValue := reflect.ValueOf(v)
+	if argValue.Type().AssignableTo(paramType) {
+		return argValue
+	}
+	if isNumericKind(argValue.Kind()) && isNumericKind(paramType.Kind()) && argValue.Type().ConvertibleTo(paramType) {
+		return argValue.Convert(paramType)
+	}
+	panic(fmt.Errorf("networkbus: forwarded argument of type %s is not assignable to handler parameter type %s", argValue.Type(), paramType))
+}
+
+func isNumericKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,178 @@
+package networkbus
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const remoteSep = "@"
+
+// joinRemote encodes an address/path pair as the opaque "remote" string
+// Transport.Publish and Transport.Subscribe take.
+func joinRemote(address, path string) string {
+	return address + remoteSep + path
+}
+
+// splitRemote reverses joinRemote.
+func splitRemote(remote string) (address, path string) {
+	if i := strings.LastIndex(remote, remoteSep); i >= 0 {
+		return remote[:i], remote[i+1:]
+	}
+	return remote, ""
+}
+
+// httpEnvelope is the routing wrapper HTTPTransport exchanges over a single
+// HTTP POST. Method names one of IncomingHandler's methods; Payload is
+// Codec-encoded and matches that method's argument type.
+type httpEnvelope struct {
+	Method  string
+	Payload []byte
+}
+
+// httpReply is the response wrapper for an httpEnvelope request.
+type httpReply struct {
+	OK    bool
+	Error string
+}
+
+// HTTPTransport is go-eventbus's original wire protocol: plain RPC-over-HTTP,
+// one POST per Register or PushEvent call, keeping backward compatibility as
+// the Transport NewServer/NewClient/NewNetworkBus default to.
+type HTTPTransport struct {
+	address string
+	path    string
+	codec   Codec
+
+	mu      sync.Mutex
+	server  *http.Server
+	started bool
+}
+
+// NewHTTPTransport returns an HTTPTransport that listens on address/path in
+// Serve and advertises address/path as its own identity in Subscribe
+// requests. A nil codec defaults to JSONCodec.
+func NewHTTPTransport(address, path string, codec Codec) *HTTPTransport {
+	if codec == nil {
+		codec = JSONCodec{}
+	}
+	return &HTTPTransport{address: address, path: path, codec: codec}
+}
+
+// Publish implements Transport.
+func (t *HTTPTransport) Publish(remote, topic string, args []any) error {
+	var reply bool
+	return t.call(remote, "PushEvent", ClientArg{Args: args, Topic: topic}, &reply)
+}
+
+// Subscribe implements Transport. It asks remote to forward topic's events
+// to this transport's own address/path.
+func (t *HTTPTransport) Subscribe(topic string, remote string) error {
+	var reply bool
+	return t.call(remote, "Register", SubscribeArg{
+		RemoteAddress: t.address,
+		RemotePath:    t.path,
+		Service:       PublishService,
+		Action:        Subscribe,
+		Topic:         topic,
+	}, &reply)
+}
+
+// call marshals arg with the transport's codec, sends it to remote as an
+// httpEnvelope for method, and decodes the reply.
+func (t *HTTPTransport) call(remote, method string, arg any, reply *bool) error {
+	payload, err := t.codec.Marshal(arg)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(httpEnvelope{Method: method, Payload: payload})
+	if err != nil {
+		return err
+	}
+	address, path := splitRemote(remote)
+	resp, err := http.Post("http://"+address+path, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	var r httpReply
+	if err := json.NewDecoder(resp.Body).Decode(&r); err != nil {
+		return err
+	}
+	if r.Error != "" {
+		return errors.New(r.Error)
+	}
+	*reply = r.OK
+	return nil
+}
+
+// Serve implements Transport. It listens on t.address and routes every
+// POST to t.path into handler.Register or handler.PushEvent.
+func (t *HTTPTransport) Serve(handler IncomingHandler) error {
+	ln, err := net.Listen("tcp", t.address)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(t.path, func(w http.ResponseWriter, r *http.Request) {
+		t.handle(handler, w, r)
+	})
+	server := &http.Server{Handler: mux}
+
+	t.mu.Lock()
+	t.server = server
+	t.started = true
+	t.mu.Unlock()
+
+	go server.Serve(ln)
+	return nil
+}
+
+func (t *HTTPTransport) handle(handler IncomingHandler, w http.ResponseWriter, r *http.Request) {
+	var env httpEnvelope
+	if err := json.NewDecoder(r.Body).Decode(&env); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var reply httpReply
+	var ok bool
+	var err error
+	switch env.Method {
+	case "Register":
+		var arg SubscribeArg
+		if err = t.codec.Unmarshal(env.Payload, &arg); err == nil {
+			err = handler.Register(&arg, &ok)
+		}
+	case "PushEvent":
+		var arg ClientArg
+		if err = t.codec.Unmarshal(env.Payload, &arg); err == nil {
+			err = handler.PushEvent(&arg, &ok)
+		}
+	default:
+		err = fmt.Errorf("networkbus: unknown method %q", env.Method)
+	}
+	reply.OK = ok
+	if err != nil {
+		reply.Error = err.Error()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(reply)
+}
+
+// Close implements Transport.
+func (t *HTTPTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.started = false
+	if t.server == nil {
+		return nil
+	}
+	return t.server.Close()
+}
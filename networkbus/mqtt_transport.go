@@ -0,0 +1,128 @@
+package networkbus
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+// MQTTTransport implements Transport over MQTT topics. Requires
+// github.com/eclipse/paho.mqtt.golang as a dependency.
+//
+// Like NATSTransport and RedisTransport, MQTT has no request/reply built
+// in, so Subscribe is fire-and-forget: it publishes a registration message
+// on the remote's well-known register topic without waiting for an
+// acknowledgement.
+//
+// MQTTTransport does not take ownership of client: the caller connected it
+// and is responsible for disconnecting it, so Close only unsubscribes the
+// MQTT topics this transport subscribed to, leaving the client itself (and
+// any other consumer sharing it) untouched.
+type MQTTTransport struct {
+	client mqtt.Client
+	codec  Codec
+	self   string
+
+	mu     sync.Mutex
+	topics []string // MQTT topics subscribed to via Serve, unsubscribed by Close
+}
+
+// NewMQTTTransport returns an MQTTTransport using client, which must already
+// be connected. self identifies this transport to remote peers, analogous
+// to HTTPTransport's address/path. A nil codec defaults to JSONCodec.
+func NewMQTTTransport(client mqtt.Client, self string, codec Codec) *MQTTTransport {
+	if codec == nil {
+		codec = JSONCodec{}
+	}
+	return &MQTTTransport{client: client, codec: codec, self: self}
+}
+
+// Publish implements Transport.
+func (t *MQTTTransport) Publish(remote, topic string, args []any) error {
+	payload, err := t.codec.Marshal(ClientArg{Args: args, Topic: topic})
+	if err != nil {
+		return err
+	}
+	token := t.client.Publish(eventTopic(remote, topic), 0, false, payload)
+	token.WaitTimeout(5 * time.Second)
+	return token.Error()
+}
+
+// Subscribe implements Transport.
+func (t *MQTTTransport) Subscribe(topic string, remote string) error {
+	payload, err := t.codec.Marshal(SubscribeArg{
+		RemoteAddress: t.self,
+		Service:       PublishService,
+		Action:        Subscribe,
+		Topic:         topic,
+	})
+	if err != nil {
+		return err
+	}
+	token := t.client.Publish(registerTopic(remote), 0, false, payload)
+	token.WaitTimeout(5 * time.Second)
+	return token.Error()
+}
+
+// Serve implements Transport: it subscribes to this transport's own
+// register topic, dispatching registrations to handler, and subscribes to
+// the matching per-topic event topic for each registered topic.
+func (t *MQTTTransport) Serve(handler IncomingHandler) error {
+	reg := registerTopic(t.self)
+	token := t.client.Subscribe(reg, 0, func(c mqtt.Client, msg mqtt.Message) {
+		var arg SubscribeArg
+		if err := t.codec.Unmarshal(msg.Payload(), &arg); err != nil {
+			return
+		}
+		var reply bool
+		_ = handler.Register(&arg, &reply)
+
+		evt := eventTopic(t.self, arg.Topic)
+		c.Subscribe(evt, 0, func(c mqtt.Client, msg mqtt.Message) {
+			var ca ClientArg
+			if err := t.codec.Unmarshal(msg.Payload(), &ca); err != nil {
+				return
+			}
+			var r bool
+			_ = handler.PushEvent(&ca, &r)
+		})
+		t.mu.Lock()
+		t.topics = append(t.topics, evt)
+		t.mu.Unlock()
+	})
+	token.WaitTimeout(5 * time.Second)
+	if err := token.Error(); err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.topics = append(t.topics, reg)
+	t.mu.Unlock()
+	return nil
+}
+
+// Close implements Transport. It unsubscribes from the MQTT topics Serve
+// subscribed to; it does not disconnect client, which the caller owns and
+// may be sharing with other components.
+func (t *MQTTTransport) Close() error {
+	t.mu.Lock()
+	topics := t.topics
+	t.topics = nil
+	t.mu.Unlock()
+
+	if len(topics) == 0 {
+		return nil
+	}
+	token := t.client.Unsubscribe(topics...)
+	token.WaitTimeout(5 * time.Second)
+	return token.Error()
+}
+
+func eventTopic(remote, topic string) string {
+	return fmt.Sprintf("eventbus/%s/event/%s", remote, topic)
+}
+
+func registerTopic(remote string) string {
+	return fmt.Sprintf("eventbus/%s/register", remote)
+}
@@ -0,0 +1,120 @@
+package networkbus
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/nats-io/nats.go"
+)
+
+// NATSTransport implements Transport over NATS subjects. Requires
+// github.com/nats-io/nats.go as a dependency.
+//
+// Unlike HTTPTransport's synchronous RPC-over-HTTP, NATS pub/sub has no
+// built-in request/reply for Register, so Subscribe is fire-and-forget: it
+// publishes a registration message on the remote's well-known register
+// subject and does not wait for an acknowledgement.
+type NATSTransport struct {
+	conn  *nats.Conn
+	codec Codec
+	self  string // this transport's own identity, advertised in Subscribe
+
+	mu   sync.Mutex
+	subs []*nats.Subscription
+}
+
+// NewNATSTransport connects to the NATS server at url. self identifies this
+// transport to remote peers (analogous to HTTPTransport's address/path) and
+// is used to build the subjects Publish sends forwarded events to. A nil
+// codec defaults to JSONCodec.
+func NewNATSTransport(url, self string, codec Codec) (*NATSTransport, error) {
+	conn, err := nats.Connect(url)
+	if err != nil {
+		return nil, err
+	}
+	if codec == nil {
+		codec = JSONCodec{}
+	}
+	return &NATSTransport{conn: conn, codec: codec, self: self}, nil
+}
+
+// Publish implements Transport.
+func (t *NATSTransport) Publish(remote, topic string, args []any) error {
+	payload, err := t.codec.Marshal(ClientArg{Args: args, Topic: topic})
+	if err != nil {
+		return err
+	}
+	return t.conn.Publish(eventSubject(remote, topic), payload)
+}
+
+// Subscribe implements Transport.
+func (t *NATSTransport) Subscribe(topic string, remote string) error {
+	payload, err := t.codec.Marshal(SubscribeArg{
+		RemoteAddress: t.self,
+		Service:       PublishService,
+		Action:        Subscribe,
+		Topic:         topic,
+	})
+	if err != nil {
+		return err
+	}
+	return t.conn.Publish(registerSubject(remote), payload)
+}
+
+// Serve implements Transport: it subscribes to this transport's own
+// register subject, dispatching registrations to handler, and to its own
+// event subject per registered topic once handler wires one up via
+// PushEvent.
+func (t *NATSTransport) Serve(handler IncomingHandler) error {
+	sub, err := t.conn.Subscribe(registerSubject(t.self), func(msg *nats.Msg) {
+		var arg SubscribeArg
+		if err := t.codec.Unmarshal(msg.Data, &arg); err != nil {
+			return
+		}
+		var reply bool
+		_ = handler.Register(&arg, &reply)
+		// Once registered, start relaying events addressed to us for this topic.
+		eventsSub, err := t.conn.Subscribe(eventSubject(t.self, arg.Topic), func(m *nats.Msg) {
+			var ca ClientArg
+			if err := t.codec.Unmarshal(m.Data, &ca); err != nil {
+				return
+			}
+			var r bool
+			_ = handler.PushEvent(&ca, &r)
+		})
+		if err == nil {
+			t.mu.Lock()
+			t.subs = append(t.subs, eventsSub)
+			t.mu.Unlock()
+		}
+	})
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.subs = append(t.subs, sub)
+	t.mu.Unlock()
+	return nil
+}
+
+// Close implements Transport.
+func (t *NATSTransport) Close() error {
+	t.mu.Lock()
+	subs := t.subs
+	t.subs = nil
+	t.mu.Unlock()
+
+	for _, sub := range subs {
+		_ = sub.Unsubscribe()
+	}
+	t.conn.Close()
+	return nil
+}
+
+func eventSubject(remote, topic string) string {
+	return fmt.Sprintf("eventbus.%s.event.%s", remote, topic)
+}
+
+func registerSubject(remote string) string {
+	return fmt.Sprintf("eventbus.%s.register", remote)
+}
@@ -0,0 +1,191 @@
+package networkbus
+
+import (
+	"reflect"
+	"sync"
+
+	eventbus "github.com/sotvokun/go-eventbus"
+)
+
+// NetworkBus federates a local eventbus.Bus with remote peers over a
+// Transport: publishing a topic that a remote peer has subscribed to (via
+// Subscribe) forwards the event there, and Subscribe-ing to a topic here
+// asks the remote to forward its events back.
+type NetworkBus struct {
+	address   string
+	path      string
+	eventBus  eventbus.Bus
+	transport Transport
+	service   *rpcService
+}
+
+// NewServer returns a NetworkBus bound to address/path, wrapping bus.
+// transport defaults to an HTTPTransport bound to the same address/path if
+// omitted.
+func NewServer(address, path string, bus eventbus.Bus, transport ...Transport) *NetworkBus {
+	return newNetworkBus(address, path, bus, resolveTransport(address, path, transport))
+}
+
+// NewClient is identical to NewServer; NetworkBus makes no client/server
+// distinction, since either side can both publish and receive forwarded
+// events.
+func NewClient(address, path string, bus eventbus.Bus, transport ...Transport) *NetworkBus {
+	return newNetworkBus(address, path, bus, resolveTransport(address, path, transport))
+}
+
+// NewNetworkBus is NewServer/NewClient with a fresh eventbus.New() bus.
+func NewNetworkBus(address, path string, transport ...Transport) *NetworkBus {
+	return newNetworkBus(address, path, eventbus.New(), resolveTransport(address, path, transport))
+}
+
+func resolveTransport(address, path string, transport []Transport) Transport {
+	if len(transport) > 0 {
+		return transport[0]
+	}
+	return NewHTTPTransport(address, path, JSONCodec{})
+}
+
+func newNetworkBus(address, path string, bus eventbus.Bus, transport Transport) *NetworkBus {
+	nb := &NetworkBus{address: address, path: path, eventBus: bus, transport: transport}
+	nb.service = newRPCService(nb)
+	return nb
+}
+
+// EventBus returns the local bus NetworkBus wraps.
+func (nb *NetworkBus) EventBus() eventbus.Bus {
+	return nb.eventBus
+}
+
+// Start begins accepting incoming registrations and forwarded events.
+func (nb *NetworkBus) Start() error {
+	if err := nb.transport.Serve(nb.service); err != nil {
+		return err
+	}
+	nb.service.mu.Lock()
+	nb.service.started = true
+	nb.service.mu.Unlock()
+	return nil
+}
+
+// Stop stops accepting incoming traffic.
+func (nb *NetworkBus) Stop() error {
+	err := nb.transport.Close()
+	nb.service.mu.Lock()
+	nb.service.started = false
+	nb.service.mu.Unlock()
+	return err
+}
+
+// Subscribe subscribes fn to topic on the local bus, and asks the remote
+// NetworkBus at remoteAddress/remotePath to start forwarding topic's
+// published events here.
+func (nb *NetworkBus) Subscribe(topic string, fn any, remoteAddress, remotePath string) error {
+	if err := nb.eventBus.Subscribe(topic, fn); err != nil {
+		return err
+	}
+	// Events arriving via PushEvent were decoded by a Codec and have lost
+	// their original Go numeric types (e.g. JSONCodec turns every number
+	// into a float64), so this topic needs the coercing processor instead
+	// of the bus's exact-assignability default.
+	nb.eventBus.SetArgumentProcessor(topic, coercingArgumentProcessor)
+	return nb.transport.Subscribe(topic, joinRemote(remoteAddress, remotePath))
+}
+
+// rpcService is the IncomingHandler NetworkBus wires into its Transport. It
+// tracks which remotes have asked to receive which topics, and installs one
+// forwarding subscription per topic on the local bus the first time a remote
+// asks for it.
+type rpcService struct {
+	nb *NetworkBus
+
+	mu         sync.Mutex
+	started    bool
+	forwarders map[string][]string // topic -> remotes ("address@path")
+}
+
+func newRPCService(nb *NetworkBus) *rpcService {
+	return &rpcService{nb: nb, forwarders: make(map[string][]string)}
+}
+
+// Register implements IncomingHandler. It records (or forgets) args.Topic's
+// interest for the remote named by args.RemoteAddress/args.RemotePath, and
+// the first time a topic gains a forwarder, subscribes a handler on the
+// local bus that relays every future publish on that topic to all of its
+// current forwarders via the Transport.
+func (s *rpcService) Register(args *SubscribeArg, reply *bool) error {
+	remote := joinRemote(args.RemoteAddress, args.RemotePath)
+
+	s.mu.Lock()
+	// A topic's forwarders entry exists from its first Subscribe on, even
+	// once every remote has unsubscribed, since the forwarding handler stays
+	// installed on the local bus.
+	_, handlerInstalled := s.forwarders[args.Topic]
+	if args.Action == Unsubscribe {
+		if handlerInstalled {
+			s.forwarders[args.Topic] = removeRemote(s.forwarders[args.Topic], remote)
+		}
+	} else if !containsRemote(s.forwarders[args.Topic], remote) {
+		s.forwarders[args.Topic] = append(s.forwarders[args.Topic], remote)
+	}
+	needsHandler := !handlerInstalled && args.Action != Unsubscribe
+	s.mu.Unlock()
+
+	if needsHandler {
+		if err := s.nb.eventBus.Subscribe(args.Topic, s.forwardFunc(args.Topic)); err != nil {
+			*reply = false
+			return err
+		}
+	}
+	*reply = true
+	return nil
+}
+
+// forwardFunc returns a variadic func(...any) handler for topic that relays
+// every publish to the remotes currently registered for it.
+func (s *rpcService) forwardFunc(topic string) any {
+	fnType := reflect.TypeOf(func(...any) {})
+	impl := func(in []reflect.Value) []reflect.Value {
+		argsValue := in[0]
+		args := make([]any, argsValue.Len())
+		for i := range args {
+			args[i] = argsValue.Index(i).Interface()
+		}
+
+		s.mu.Lock()
+		remotes := append([]string(nil), s.forwarders[topic]...)
+		s.mu.Unlock()
+
+		for _, remote := range remotes {
+			_ = s.nb.transport.Publish(remote, topic, args)
+		}
+		return nil
+	}
+	return reflect.MakeFunc(fnType, impl).Interface()
+}
+
+// PushEvent implements IncomingHandler: it publishes a forwarded event on
+// the local bus.
+func (s *rpcService) PushEvent(args *ClientArg, reply *bool) error {
+	s.nb.eventBus.Publish(args.Topic, args.Args...)
+	*reply = true
+	return nil
+}
+
+func containsRemote(remotes []string, remote string) bool {
+	for _, r := range remotes {
+		if r == remote {
+			return true
+		}
+	}
+	return false
+}
+
+func removeRemote(remotes []string, remote string) []string {
+	out := remotes[:0]
+	for _, r := range remotes {
+		if r != remote {
+			out = append(out, r)
+		}
+	}
+	return out
+}
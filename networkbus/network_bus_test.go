@@ -2,6 +2,7 @@ package networkbus
 
 import (
 	"testing"
+	"time"
 
 	"github.com/sotvokun/go-eventbus"
 )
@@ -43,6 +44,28 @@ func TestRegister(t *testing.T) {
 	}
 }
 
+// TestRegisterUnsubscribeBeforeSubscribeInstallsHandler reproduces a stray
+// Unsubscribe arriving for a topic that never had a forwarder (plausible
+// given the NATS/Redis/MQTT transports' fire-and-forget, unordered
+// delivery): it must not leave a stale forwarders entry that makes a later
+// genuine Subscribe think the forwarding handler is already installed and
+// skip installing it.
+func TestRegisterUnsubscribeBeforeSubscribeInstallsHandler(t *testing.T) {
+	serverPath := "/_server_bus_c"
+	serverBus := NewServer(":2011", serverPath, eventbus.New())
+
+	remote := &SubscribeArg{serverBus.address, serverPath, PublishService, Unsubscribe, "topic"}
+	reply := new(bool)
+	serverBus.service.Register(remote, reply)
+
+	subscribe := &SubscribeArg{serverBus.address, serverPath, PublishService, Subscribe, "topic"}
+	serverBus.service.Register(subscribe, reply)
+
+	if !serverBus.eventBus.HasCallback("topic") {
+		t.Fatal("expected Subscribe to install the forwarding handler despite a preceding stray Unsubscribe")
+	}
+}
+
 func TestPushEvent(t *testing.T) {
 	clientBus := NewClient("localhost:2015", "/_client_bus_", eventbus.New())
 
@@ -52,10 +75,9 @@ func TestPushEvent(t *testing.T) {
 	clientArg := &ClientArg{eventArgs, "topic"}
 	reply := new(bool)
 
+	got := make(chan int, 1)
 	fn := func(a int) {
-		if a != 10 {
-			t.Fail()
-		}
+		got <- a
 	}
 
 	clientBus.eventBus.Subscribe("topic", fn)
@@ -63,25 +85,46 @@ func TestPushEvent(t *testing.T) {
 	if !(*reply) {
 		t.Fail()
 	}
+
+	select {
+	case a := <-got:
+		if a != 10 {
+			t.Fatalf("expected 10, got %d", a)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the pushed event to reach the subscriber")
+	}
 }
 
+// TestServerPublish exercises a real HTTP round trip: its args are JSON
+// encoded by the codec and decoded back as float64 before reaching the
+// subscriber, which requires the coercing ArgumentProcessor NetworkBus.Subscribe
+// installs to deliver them as int.
 func TestServerPublish(t *testing.T) {
 	serverBus := NewServer(":2020", "/_server_bus_b", eventbus.New())
 	serverBus.Start()
 
+	got := make(chan int, 1)
 	fn := func(a int) {
-		if a != 10 {
-			t.Fail()
-		}
+		got <- a
 	}
 
 	clientBus := NewClient(":2025", "/_client_bus_b", eventbus.New())
 	clientBus.Start()
 
-	clientBus.Subscribe("topic", fn, ":2010", "/_server_bus_b")
+	clientBus.Subscribe("topic", fn, ":2020", "/_server_bus_b")
 
 	serverBus.EventBus().Publish("topic", 10)
 
+	select {
+	case a := <-got:
+		if a != 10 {
+			t.Fatalf("expected 10, got %d", a)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected the subscriber to receive the published event over the network")
+	}
+
 	clientBus.Stop()
 	serverBus.Stop()
 }
@@ -93,22 +136,38 @@ func TestNetworkBus(t *testing.T) {
 	networkBusB := NewNetworkBus(":2030", "/_net_bus_B")
 	networkBusB.Start()
 
+	gotA := make(chan int, 1)
 	fnA := func(a int) {
-		if a != 10 {
-			t.Fail()
-		}
+		gotA <- a
 	}
 	networkBusA.Subscribe("topic-A", fnA, ":2030", "/_net_bus_B")
 	networkBusB.EventBus().Publish("topic-A", 10)
 
-	fnB := func(a int) {
-		if a != 20 {
-			t.Fail()
+	select {
+	case a := <-gotA:
+		if a != 10 {
+			t.Fatalf("expected 10, got %d", a)
 		}
+	case <-time.After(time.Second):
+		t.Fatal("expected networkBusA's subscriber to receive topic-A's published event")
+	}
+
+	gotB := make(chan int, 1)
+	fnB := func(a int) {
+		gotB <- a
 	}
 	networkBusB.Subscribe("topic-B", fnB, ":2035", "/_net_bus_A")
 	networkBusA.EventBus().Publish("topic-B", 20)
 
+	select {
+	case a := <-gotB:
+		if a != 20 {
+			t.Fatalf("expected 20, got %d", a)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected networkBusB's subscriber to receive topic-B's published event")
+	}
+
 	networkBusA.Stop()
 	networkBusB.Stop()
 }
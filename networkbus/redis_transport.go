@@ -0,0 +1,137 @@
+package networkbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisTransport implements Transport over Redis pub/sub channels. Requires
+// github.com/redis/go-redis/v9 as a dependency.
+//
+// Like NATSTransport, Redis pub/sub has no request/reply built in, so
+// Subscribe is fire-and-forget: it publishes a registration message on the
+// remote's register channel without waiting for an acknowledgement.
+type RedisTransport struct {
+	client *redis.Client
+	codec  Codec
+	self   string
+	cancel context.CancelFunc
+
+	mu          sync.Mutex
+	registerSub *redis.PubSub
+	eventSubs   []*redis.PubSub // one per serveTopic call, closed by Close
+}
+
+// NewRedisTransport returns a RedisTransport using client. self identifies
+// this transport to remote peers, analogous to HTTPTransport's address/path.
+// A nil codec defaults to JSONCodec.
+func NewRedisTransport(client *redis.Client, self string, codec Codec) *RedisTransport {
+	if codec == nil {
+		codec = JSONCodec{}
+	}
+	return &RedisTransport{client: client, codec: codec, self: self}
+}
+
+// Publish implements Transport.
+func (t *RedisTransport) Publish(remote, topic string, args []any) error {
+	payload, err := t.codec.Marshal(ClientArg{Args: args, Topic: topic})
+	if err != nil {
+		return err
+	}
+	return t.client.Publish(context.Background(), eventChannel(remote, topic), payload).Err()
+}
+
+// Subscribe implements Transport.
+func (t *RedisTransport) Subscribe(topic string, remote string) error {
+	payload, err := t.codec.Marshal(SubscribeArg{
+		RemoteAddress: t.self,
+		Service:       PublishService,
+		Action:        Subscribe,
+		Topic:         topic,
+	})
+	if err != nil {
+		return err
+	}
+	return t.client.Publish(context.Background(), registerChannel(remote), payload).Err()
+}
+
+// Serve implements Transport: it listens on this transport's own register
+// channel, dispatching registrations to handler, and subscribes to the
+// matching per-topic event channel for each registered topic.
+func (t *RedisTransport) Serve(handler IncomingHandler) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+
+	registerSub := t.client.Subscribe(ctx, registerChannel(t.self))
+	t.mu.Lock()
+	t.registerSub = registerSub
+	t.mu.Unlock()
+	go func() {
+		for msg := range registerSub.Channel() {
+			var arg SubscribeArg
+			if err := t.codec.Unmarshal([]byte(msg.Payload), &arg); err != nil {
+				continue
+			}
+			var reply bool
+			_ = handler.Register(&arg, &reply)
+			t.serveTopic(ctx, arg.Topic, handler)
+		}
+	}()
+	return nil
+}
+
+func (t *RedisTransport) serveTopic(ctx context.Context, topic string, handler IncomingHandler) {
+	eventsSub := t.client.Subscribe(ctx, eventChannel(t.self, topic))
+	t.mu.Lock()
+	t.eventSubs = append(t.eventSubs, eventsSub)
+	t.mu.Unlock()
+	go func() {
+		for msg := range eventsSub.Channel() {
+			var arg ClientArg
+			if err := t.codec.Unmarshal([]byte(msg.Payload), &arg); err != nil {
+				continue
+			}
+			var reply bool
+			_ = handler.PushEvent(&arg, &reply)
+		}
+	}()
+}
+
+// Close implements Transport. It cancels the context driving Serve's and
+// serveTopic's dispatch goroutines and closes every subscription they
+// opened, so neither the register subscription nor any per-topic event
+// subscription outlives the transport.
+func (t *RedisTransport) Close() error {
+	if t.cancel != nil {
+		t.cancel()
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var errs []error
+	if t.registerSub != nil {
+		if err := t.registerSub.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	for _, sub := range t.eventSubs {
+		if err := sub.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	t.eventSubs = nil
+	return errors.Join(errs...)
+}
+
+func eventChannel(remote, topic string) string {
+	return fmt.Sprintf("eventbus:%s:event:%s", remote, topic)
+}
+
+func registerChannel(remote string) string {
+	return fmt.Sprintf("eventbus:%s:register", remote)
+}
@@ -0,0 +1,20 @@
+package networkbus
+
+// Transport carries NetworkBus registration requests and forwarded events
+// between peers. HTTPTransport (the package's original RPC-over-HTTP wire
+// protocol) is the default and only transport with synchronous
+// request/reply semantics; NATSTransport, RedisTransport and MQTTTransport
+// trade that for running over pub/sub systems users may already operate.
+type Transport interface {
+	// Publish forwards topic's args to remote, the opaque peer identifier
+	// returned by a prior Subscribe call's target (see joinRemote).
+	Publish(remote, topic string, args []any) error
+	// Subscribe asks remote to start forwarding topic's published events
+	// back to this Transport.
+	Subscribe(topic string, remote string) error
+	// Serve starts accepting incoming registrations and forwarded events,
+	// dispatching them to handler.
+	Serve(handler IncomingHandler) error
+	// Close stops Serve and releases any resources it holds.
+	Close() error
+}
@@ -0,0 +1,48 @@
+package networkbus
+
+// Service names the local RPC-style service a SubscribeArg's forwarded
+// events should be delivered to. PublishService is the only one shipped
+// today: it routes a forwarded event into the receiving NetworkBus's
+// EventBus.Publish.
+type Service int
+
+const (
+	// PublishService forwards events into the receiving NetworkBus's EventBus.
+	PublishService Service = iota
+)
+
+// Action distinguishes the two things a remote peer can ask a NetworkBus to
+// do for one of its topics.
+type Action int
+
+const (
+	// Subscribe asks the receiving NetworkBus to start forwarding events
+	// published on Topic to RemoteAddress/RemotePath.
+	Subscribe Action = iota
+	// Unsubscribe asks it to stop.
+	Unsubscribe
+)
+
+// SubscribeArg is the payload of a Register call: a request to forward (or
+// stop forwarding) Topic's published events to a remote peer.
+type SubscribeArg struct {
+	RemoteAddress string
+	RemotePath    string
+	Service       Service
+	Action        Action
+	Topic         string
+}
+
+// ClientArg is the payload of a PushEvent call: a forwarded publish.
+type ClientArg struct {
+	Args  []any
+	Topic string
+}
+
+// IncomingHandler is implemented by the object wired into a Transport's
+// Serve call. It receives registration requests and forwarded events from
+// remote peers, regardless of which Transport carried them.
+type IncomingHandler interface {
+	Register(args *SubscribeArg, reply *bool) error
+	PushEvent(args *ClientArg, reply *bool) error
+}
@@ -0,0 +1,63 @@
+package eventbus
+
+import "reflect"
+
+// Topic is a generics-based façade over Bus for a single topic name. It
+// gives callers a compile-time-safe Subscribe/Publish API for a payload
+// type T, so mismatched argument types are caught by the compiler instead
+// of surfacing as a reflect panic at publish time.
+type Topic[T any] struct {
+	bus  Bus
+	name string
+	zero reflect.Value // cached reflect.Value for T's zero value
+}
+
+// NewTopic returns a Topic bound to name on bus. It installs a dedicated
+// ArgumentProcessor for name that type-asserts the published argument to T
+// instead of going through the bus's general reflect-based argument setup.
+func NewTopic[T any](bus Bus, name string) *Topic[T] {
+	t := &Topic[T]{
+		bus:  bus,
+		name: name,
+		zero: reflect.Zero(reflect.TypeOf((*T)(nil)).Elem()),
+	}
+	bus.SetArgumentProcessor(name, t.argumentProcessor)
+	return t
+}
+
+// argumentProcessor is the Topic's ArgumentProcessor for its topic. It
+// type-asserts the published value to T, falling back to T's cached zero
+// value if Publish was never called with a T (e.g. the topic name was
+// reused with bus.Publish directly).
+func (t *Topic[T]) argumentProcessor(handler *EventHandler, args ...any) []reflect.Value {
+	if len(args) == 1 {
+		if v, ok := args[0].(T); ok {
+			return []reflect.Value{reflect.ValueOf(v)}
+		}
+	}
+	return []reflect.Value{t.zero}
+}
+
+// Subscribe subscribes fn to the topic. Returns error if the underlying bus
+// rejects the subscription.
+func (t *Topic[T]) Subscribe(fn func(T)) error {
+	return t.bus.Subscribe(t.name, fn)
+}
+
+// SubscribeAsync subscribes fn to the topic with an asynchronous callback.
+// transactional determines whether subsequent callbacks run serially (true)
+// or concurrently (false).
+func (t *Topic[T]) SubscribeAsync(fn func(T), transactional bool) error {
+	return t.bus.SubscribeAsync(t.name, fn, transactional)
+}
+
+// Unsubscribe removes fn from the topic. fn must be the same function value
+// passed to Subscribe or SubscribeAsync.
+func (t *Topic[T]) Unsubscribe(fn func(T)) error {
+	return t.bus.Unsubscribe(t.name, fn)
+}
+
+// Publish publishes value to the topic.
+func (t *Topic[T]) Publish(value T) {
+	t.bus.Publish(t.name, value)
+}
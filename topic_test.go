@@ -0,0 +1,74 @@
+package eventbus
+
+import "testing"
+
+type orderPlaced struct {
+	ID     string
+	Amount int
+}
+
+func TestTopicPublishSubscribe(t *testing.T) {
+	bus := New()
+	topic := NewTopic[orderPlaced](bus, "orders.placed")
+
+	var got orderPlaced
+	topic.Subscribe(func(v orderPlaced) {
+		got = v
+	})
+
+	topic.Publish(orderPlaced{ID: "o1", Amount: 42})
+	if got.ID != "o1" || got.Amount != 42 {
+		t.Fatalf("expected {o1 42}, got %+v", got)
+	}
+}
+
+func TestTopicSubscribeAsync(t *testing.T) {
+	bus := New()
+	topic := NewTopic[int](bus, "counts")
+
+	done := make(chan int, 1)
+	topic.SubscribeAsync(func(v int) {
+		done <- v
+	}, false)
+
+	topic.Publish(7)
+	bus.WaitAsync()
+
+	if v := <-done; v != 7 {
+		t.Fatalf("expected 7, got %d", v)
+	}
+}
+
+func TestTopicUnsubscribe(t *testing.T) {
+	bus := New()
+	topic := NewTopic[int](bus, "counts")
+
+	var called bool
+	fn := func(int) { called = true }
+	topic.Subscribe(fn)
+	if err := topic.Unsubscribe(fn); err != nil {
+		t.Fatalf("unexpected error unsubscribing: %v", err)
+	}
+
+	topic.Publish(1)
+	if called {
+		t.Fatal("expected handler to have been removed")
+	}
+}
+
+func TestTopicArgumentProcessorFallsBackToZeroValue(t *testing.T) {
+	bus := New()
+	topic := NewTopic[orderPlaced](bus, "orders.placed")
+
+	var got orderPlaced
+	topic.Subscribe(func(v orderPlaced) {
+		got = v
+	})
+
+	// Publishing directly on the underlying bus with a mismatched type
+	// exercises the Topic's installed ArgumentProcessor fallback path.
+	bus.Publish("orders.placed", "not-an-orderPlaced")
+	if got != (orderPlaced{}) {
+		t.Fatalf("expected the zero value, got %+v", got)
+	}
+}